@@ -0,0 +1,38 @@
+package ast
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/tomsharratt/alp/token"
+)
+
+// MacroLiteral represents a `macro(...) { ... }` expression. It is only
+// ever valid on the right-hand side of a `let` statement; evaluator.
+// DefineMacros extracts those statements from the top level of a program
+// before it is evaluated, so a top-level macro definition is never seen by
+// evaluator.Eval. Definitions nested inside blocks are not extracted.
+type MacroLiteral struct {
+	Token      token.Token // the 'macro' token
+	Parameters []*Identifier
+	Body       *BlockStatement
+}
+
+func (ml *MacroLiteral) expressionNode()      {}
+func (ml *MacroLiteral) TokenLiteral() string { return ml.Token.Literal }
+func (ml *MacroLiteral) String() string {
+	var out bytes.Buffer
+
+	params := []string{}
+	for _, p := range ml.Parameters {
+		params = append(params, p.String())
+	}
+
+	out.WriteString(ml.TokenLiteral())
+	out.WriteString("(")
+	out.WriteString(strings.Join(params, ", "))
+	out.WriteString(") ")
+	out.WriteString(ml.Body.String())
+
+	return out.String()
+}
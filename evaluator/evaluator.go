@@ -116,6 +116,10 @@ func Eval(
 	case *ast.IfExpression:
 		return evalIfExpression(ctx, node, env)
 	case *ast.CallExpression:
+		if node.Function.TokenLiteral() == "quote" {
+			return quote(ctx, node.Arguments[0], env), nil
+		}
+
 		function, err := Eval(ctx, node.Function, env)
 		if err != nil {
 			return nil, err
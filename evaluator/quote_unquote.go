@@ -0,0 +1,74 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tomsharratt/alp/ast"
+	"github.com/tomsharratt/alp/object"
+	"github.com/tomsharratt/alp/token"
+)
+
+// quote builds an object.Quote wrapping node, first resolving against env
+// each unquote(...) call that ast.Modify visits inside it. An unquote call
+// inside the arguments of another call expression is not visited and is
+// left as it is.
+func quote(ctx context.Context, node ast.Node, env *object.Environment) object.Object {
+	node = evalUnquoteCalls(ctx, node, env)
+	return &object.Quote{Node: node}
+}
+
+func evalUnquoteCalls(ctx context.Context, quoted ast.Node, env *object.Environment) ast.Node {
+	return ast.Modify(quoted, func(node ast.Node) ast.Node {
+		if !isUnquoteCall(node) {
+			return node
+		}
+
+		call, ok := node.(*ast.CallExpression)
+		if !ok {
+			return node
+		}
+		if len(call.Arguments) != 1 {
+			return node
+		}
+
+		unquoted, err := Eval(ctx, call.Arguments[0], env)
+		if err != nil || isError(unquoted) {
+			return node
+		}
+
+		return convertObjectToASTNode(unquoted)
+	})
+}
+
+func isUnquoteCall(node ast.Node) bool {
+	callExpression, ok := node.(*ast.CallExpression)
+	if !ok {
+		return false
+	}
+
+	return callExpression.Function.TokenLiteral() == "unquote"
+}
+
+func convertObjectToASTNode(obj object.Object) ast.Node {
+	switch obj := obj.(type) {
+	case *object.Integer:
+		t := token.Token{Type: token.INT, Literal: fmt.Sprintf("%d", obj.Value)}
+		return &ast.IntegerLiteral{Token: t, Value: obj.Value}
+
+	case *object.Boolean:
+		var t token.Token
+		if obj.Value {
+			t = token.Token{Type: token.TRUE, Literal: "true"}
+		} else {
+			t = token.Token{Type: token.FALSE, Literal: "false"}
+		}
+		return &ast.Boolean{Token: t, Value: obj.Value}
+
+	case *object.Quote:
+		return obj.Node
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,55 @@
+package object
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/tomsharratt/alp/ast"
+)
+
+const (
+	MACRO_OBJ = "MACRO"
+	QUOTE_OBJ = "QUOTE"
+)
+
+// Macro is the run-time value bound by `let name = macro(...) { ... };`.
+// It is only produced by evaluator.DefineMacros and only consumed by
+// evaluator.ExpandMacros; Eval never evaluates a Macro the way it
+// evaluates a Function.
+type Macro struct {
+	Parameters []*ast.Identifier
+	Body       *ast.BlockStatement
+	Env        *Environment
+}
+
+func (m *Macro) Type() ObjectType { return MACRO_OBJ }
+func (m *Macro) Inspect() string {
+	var out bytes.Buffer
+
+	params := []string{}
+	for _, p := range m.Parameters {
+		params = append(params, p.String())
+	}
+
+	out.WriteString("macro")
+	out.WriteString("(")
+	out.WriteString(strings.Join(params, ", "))
+	out.WriteString(") {\n")
+	out.WriteString(m.Body.String())
+	out.WriteString("\n}")
+
+	return out.String()
+}
+
+// Quote wraps an ast.Node so it can flow through the evaluator as an
+// ordinary object.Object. quote(...) produces one, unquote(...) calls
+// inside it are resolved before the macro expander splices the wrapped
+// node back into the AST.
+type Quote struct {
+	Node ast.Node
+}
+
+func (q *Quote) Type() ObjectType { return QUOTE_OBJ }
+func (q *Quote) Inspect() string {
+	return "QUOTE(" + q.Node.String() + ")"
+}
@@ -17,6 +17,7 @@ const PROMT = ">> "
 func Run(in io.Reader, out io.Writer) {
 	scanner := bufio.NewScanner(in)
 	env := object.NewEnvironment()
+	macroEnv := object.NewEnvironment()
 
 	for {
 		fmt.Fprintf(out, "%s", PROMT)
@@ -36,7 +37,10 @@ func Run(in io.Reader, out io.Writer) {
 			continue
 		}
 
-		evaluated, err := evaluator.Eval(ctx, program, env)
+		evaluator.DefineMacros(program, macroEnv)
+		expanded := evaluator.ExpandMacros(ctx, program, macroEnv)
+
+		evaluated, err := evaluator.Eval(ctx, expanded, env)
 		if err == nil && evaluated != nil {
 			io.WriteString(out, evaluated.Inspect())
 			io.WriteString(out, "\n")